@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultRunTaskResultsGateTimeoutSeconds      = 600
+	defaultRunTaskResultsGatePollIntervalSeconds = 10
+	defaultRunTaskResultsGateRequiredStatus      = "passed"
+)
+
+// runTaskResultsGateTerminalStatuses are the TaskStage statuses at which a stage's task
+// results are no longer expected to change, so polling can stop.
+var runTaskResultsGateTerminalStatuses = map[string]bool{
+	"passed":   true,
+	"failed":   true,
+	"errored":  true,
+	"canceled": true,
+}
+
+func runTaskResultsGateRequiredStatuses() []string {
+	return []string{"passed", "failed", "errored", "canceled"}
+}
+
+// dataSourceTFERunTaskResultsGate blocks a Terraform plan/apply until every task stage on
+// a CLI-driven run has reached a terminal status, then asserts that each stage's status
+// matches required_status. This lets automation built from tfe_workspace_run read back
+// the outcomes that tfe_workspace_run_task only sets up.
+type dataSourceTFERunTaskResultsGate struct {
+	config ConfiguredClient
+}
+
+var _ datasource.DataSource = &dataSourceTFERunTaskResultsGate{}
+var _ datasource.DataSourceWithConfigure = &dataSourceTFERunTaskResultsGate{}
+
+func NewRunTaskResultsGateDataSource() datasource.DataSource {
+	return &dataSourceTFERunTaskResultsGate{}
+}
+
+type modelTFERunTaskResultsGateStage struct {
+	ID               types.String         `tfsdk:"id"`
+	Stage            types.String         `tfsdk:"stage"`
+	Status           types.String         `tfsdk:"status"`
+	StatusTimestamps types.Map            `tfsdk:"status_timestamps"`
+	TaskResults      []modelTFETaskResult `tfsdk:"task_results"`
+}
+
+func modelFromTFERunTaskResultsGateStage(v *tfe.TaskStage) modelTFERunTaskResultsGateStage {
+	return modelTFERunTaskResultsGateStage{
+		ID:               types.StringValue(v.ID),
+		Stage:            types.StringValue(string(v.Stage)),
+		Status:           types.StringValue(string(v.Status)),
+		StatusTimestamps: modelStatusTimestampsFromTFE(v.StatusTimestamps),
+		TaskResults:      modelTaskResultsFromTFE(v.TaskResults),
+	}
+}
+
+type modelTFERunTaskResultsGate struct {
+	ID                  types.String                      `tfsdk:"id"`
+	RunID               types.String                      `tfsdk:"run_id"`
+	TimeoutSeconds      types.Int64                       `tfsdk:"timeout_seconds"`
+	PollIntervalSeconds types.Int64                       `tfsdk:"poll_interval_seconds"`
+	RequiredStatus      types.String                      `tfsdk:"required_status"`
+	Stages              []modelTFERunTaskResultsGateStage `tfsdk:"stages"`
+}
+
+func (d *dataSourceTFERunTaskResultsGate) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run_task_results_gate"
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (d *dataSourceTFERunTaskResultsGate) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected data source Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+		return
+	}
+	d.config = client
+}
+
+func (d *dataSourceTFERunTaskResultsGate) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Blocks until every task stage on a run has reached a terminal status, then asserts the results against `required_status`. Useful for Terraform-driven automation that kicks off child runs (e.g. via `tfe_workspace_run`) and needs to wait for and gate on their run task outcomes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Service-generated identifier; matches `run_id`.",
+			},
+			"run_id": schema.StringAttribute{
+				Description: "The ID of the run to poll task stage results for.",
+				Required:    true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: fmt.Sprintf("How long, in seconds, to wait for every task stage to reach a terminal status before failing. Must be at least 1. Defaults to %d.", defaultRunTaskResultsGateTimeoutSeconds),
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"poll_interval_seconds": schema.Int64Attribute{
+				Description: fmt.Sprintf("How long, in seconds, to wait between polls of the run's task stages. Must be at least 1. Defaults to %d.", defaultRunTaskResultsGatePollIntervalSeconds),
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"required_status": schema.StringAttribute{
+				Description: fmt.Sprintf("The status every task stage must reach for the gate to pass. Defaults to %q. Valid values are %s.", defaultRunTaskResultsGateRequiredStatus, sentenceList(
+					runTaskResultsGateRequiredStatuses(),
+					"`",
+					"`",
+					"and",
+				)),
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(runTaskResultsGateRequiredStatuses()...),
+				},
+			},
+			"stages": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The final status and task results observed for each task stage on the run.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Service-generated identifier for the task stage.",
+						},
+						"stage": schema.StringAttribute{
+							Computed:    true,
+							Description: "The stage in the run's lifecycle, e.g. `post_plan`.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The final status of the task stage.",
+						},
+						"status_timestamps": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "A map of timestamps for key events during the task stage's lifecycle.",
+						},
+						"task_results": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The results of each run task associated with this stage.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"task_id": schema.StringAttribute{
+										Computed:    true,
+										Description: "The ID of the run task that produced this result.",
+									},
+									"status": schema.StringAttribute{
+										Computed:    true,
+										Description: "The status of the task result.",
+									},
+									"message": schema.StringAttribute{
+										Computed:    true,
+										Description: "A human-readable message describing the task result.",
+									},
+									"url": schema.StringAttribute{
+										Computed:    true,
+										Description: "A URL where the full details of the task result can be viewed.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceTFERunTaskResultsGate) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data modelTFERunTaskResultsGate
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	runID := data.RunID.ValueString()
+
+	timeoutSeconds := int64(defaultRunTaskResultsGateTimeoutSeconds)
+	if !data.TimeoutSeconds.IsNull() {
+		timeoutSeconds = data.TimeoutSeconds.ValueInt64()
+	}
+
+	pollIntervalSeconds := int64(defaultRunTaskResultsGatePollIntervalSeconds)
+	if !data.PollIntervalSeconds.IsNull() {
+		pollIntervalSeconds = data.PollIntervalSeconds.ValueInt64()
+	}
+
+	requiredStatus := defaultRunTaskResultsGateRequiredStatus
+	if !data.RequiredStatus.IsNull() {
+		requiredStatus = data.RequiredStatus.ValueString()
+	}
+
+	deadline := time.Now().Add(time.Duration(timeoutSeconds) * time.Second)
+	pollInterval := time.Duration(pollIntervalSeconds) * time.Second
+
+	var stages []*tfe.TaskStage
+	for {
+		tflog.Debug(ctx, fmt.Sprintf("Polling task stages for run: %s", runID))
+		list, err := d.config.Client.TaskStages.List(ctx, runID, &tfe.TaskStageListOptions{})
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing task stages", "Could not list task stages for run "+runID+", unexpected error: "+err.Error())
+			return
+		}
+		stages = list.Items
+
+		if allRunTaskResultsGateStagesTerminal(stages) {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			resp.Diagnostics.AddError(
+				"Timed out waiting for run task results",
+				fmt.Sprintf("Not every task stage on run %s reached a terminal status within %d seconds.", runID, timeoutSeconds),
+			)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			resp.Diagnostics.AddError("Context canceled", "Stopped waiting for run task results: "+ctx.Err().Error())
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+
+	stageModels := make([]modelTFERunTaskResultsGateStage, 0, len(stages))
+	var failed []string
+	for _, ts := range stages {
+		stageModels = append(stageModels, modelFromTFERunTaskResultsGateStage(ts))
+		if string(ts.Status) != requiredStatus {
+			failed = append(failed, fmt.Sprintf("%s (status: %s)", ts.Stage, ts.Status))
+		}
+	}
+
+	if len(failed) > 0 {
+		resp.Diagnostics.AddError(
+			"Run task results did not meet required_status",
+			fmt.Sprintf("Expected every task stage on run %s to reach status %q, but the following did not: %s.", runID, requiredStatus, sentenceList(failed, "", "", "and")),
+		)
+		return
+	}
+
+	result := modelTFERunTaskResultsGate{
+		ID:                  types.StringValue(runID),
+		RunID:               types.StringValue(runID),
+		TimeoutSeconds:      types.Int64Value(timeoutSeconds),
+		PollIntervalSeconds: types.Int64Value(pollIntervalSeconds),
+		RequiredStatus:      types.StringValue(requiredStatus),
+		Stages:              stageModels,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+func allRunTaskResultsGateStagesTerminal(stages []*tfe.TaskStage) bool {
+	// A run with no task stages has nothing to gate on, so it counts as terminal rather
+	// than polling for the full timeout.
+	for _, ts := range stages {
+		if !runTaskResultsGateTerminalStatuses[string(ts.Status)] {
+			return false
+		}
+	}
+	return true
+}
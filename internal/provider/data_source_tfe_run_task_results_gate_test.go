@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+func TestAllRunTaskResultsGateStagesTerminal(t *testing.T) {
+	cases := map[string]struct {
+		stages []*tfe.TaskStage
+		want   bool
+	}{
+		"no stages is terminal": {
+			stages: nil,
+			want:   true,
+		},
+		"all terminal": {
+			stages: []*tfe.TaskStage{
+				{Status: "passed"},
+				{Status: "failed"},
+			},
+			want: true,
+		},
+		"one still running": {
+			stages: []*tfe.TaskStage{
+				{Status: "passed"},
+				{Status: "running"},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := allRunTaskResultsGateStagesTerminal(tc.stages); got != tc.want {
+				t.Errorf("allRunTaskResultsGateStagesTerminal() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
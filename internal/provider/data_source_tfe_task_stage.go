@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type dataSourceTFETaskStage struct {
+	config ConfiguredClient
+}
+
+var _ datasource.DataSource = &dataSourceTFETaskStage{}
+var _ datasource.DataSourceWithConfigure = &dataSourceTFETaskStage{}
+
+func NewTaskStageDataSource() datasource.DataSource {
+	return &dataSourceTFETaskStage{}
+}
+
+type modelTFETaskResult struct {
+	TaskID  types.String `tfsdk:"task_id"`
+	Status  types.String `tfsdk:"status"`
+	Message types.String `tfsdk:"message"`
+	URL     types.String `tfsdk:"url"`
+}
+
+type modelTFETaskStage struct {
+	ID               types.String         `tfsdk:"id"`
+	TaskStageID      types.String         `tfsdk:"task_stage_id"`
+	RunID            types.String         `tfsdk:"run_id"`
+	Stage            types.String         `tfsdk:"stage"`
+	Status           types.String         `tfsdk:"status"`
+	StatusTimestamps types.Map            `tfsdk:"status_timestamps"`
+	TaskResults      []modelTFETaskResult `tfsdk:"task_results"`
+}
+
+// modelStatusTimestampsFromTFE converts the non-zero fields of a TaskStage's status
+// timestamps into a map keyed by event name, e.g. "running_at" or "passed_at".
+func modelStatusTimestampsFromTFE(v *tfe.TaskStageStatusTimestamps) types.Map {
+	timestamps := make(map[string]attr.Value)
+	if v != nil {
+		if !v.ErroredAt.IsZero() {
+			timestamps["errored_at"] = types.StringValue(v.ErroredAt.Format(timeFormatRFC3339))
+		}
+		if !v.FailedAt.IsZero() {
+			timestamps["failed_at"] = types.StringValue(v.FailedAt.Format(timeFormatRFC3339))
+		}
+		if !v.PassedAt.IsZero() {
+			timestamps["passed_at"] = types.StringValue(v.PassedAt.Format(timeFormatRFC3339))
+		}
+		if !v.RunningAt.IsZero() {
+			timestamps["running_at"] = types.StringValue(v.RunningAt.Format(timeFormatRFC3339))
+		}
+	}
+
+	mapValue, _ := types.MapValue(types.StringType, timestamps)
+	return mapValue
+}
+
+// modelTaskResultsFromTFE converts a TaskStage's task results into their Terraform model.
+func modelTaskResultsFromTFE(taskResults []*tfe.TaskResult) []modelTFETaskResult {
+	results := make([]modelTFETaskResult, 0, len(taskResults))
+	for _, r := range taskResults {
+		taskID := ""
+		if r.RunTask != nil {
+			taskID = r.RunTask.ID
+		}
+		results = append(results, modelTFETaskResult{
+			TaskID:  types.StringValue(taskID),
+			Status:  types.StringValue(string(r.Status)),
+			Message: types.StringValue(r.Message),
+			URL:     types.StringValue(r.URL),
+		})
+	}
+	return results
+}
+
+func modelFromTFETaskStage(v *tfe.TaskStage) modelTFETaskStage {
+	runID := ""
+	if v.Run != nil {
+		runID = v.Run.ID
+	}
+
+	return modelTFETaskStage{
+		ID:               types.StringValue(v.ID),
+		TaskStageID:      types.StringValue(v.ID),
+		RunID:            types.StringValue(runID),
+		Stage:            types.StringValue(string(v.Stage)),
+		Status:           types.StringValue(string(v.Status)),
+		StatusTimestamps: modelStatusTimestampsFromTFE(v.StatusTimestamps),
+		TaskResults:      modelTaskResultsFromTFE(v.TaskResults),
+	}
+}
+
+func (d *dataSourceTFETaskStage) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task_stage"
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (d *dataSourceTFETaskStage) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected data source Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+		return
+	}
+	d.config = client
+}
+
+func (d *dataSourceTFETaskStage) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the status and results of a run task stage, as created by `tfe_workspace_run_task` executions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Service-generated identifier for the task stage.",
+			},
+			"task_stage_id": schema.StringAttribute{
+				Description: "The ID of the task stage to read. Conflicts with `run_id` and `stage`.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("run_id"), path.MatchRoot("stage")),
+				},
+			},
+			"run_id": schema.StringAttribute{
+				Description: "The ID of the run that the task stage belongs to. Conflicts with `task_stage_id`. Required (along with `stage`) unless `task_stage_id` is set, in which case it is read from the fetched task stage.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("task_stage_id")),
+					stringvalidator.AlsoRequires(path.MatchRoot("stage")),
+				},
+			},
+			"stage": schema.StringAttribute{
+				Description: fmt.Sprintf("The stage in the run's lifecycle to read results for. Conflicts with `task_stage_id`. Required (along with `run_id`) unless `task_stage_id` is set, in which case it is read from the fetched task stage. Valid values are %s.", sentenceList(
+					workspaceRunTaskStages(),
+					"`",
+					"`",
+					"and",
+				)),
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(workspaceRunTaskStages()...),
+					stringvalidator.ConflictsWith(path.MatchRoot("task_stage_id")),
+					stringvalidator.AlsoRequires(path.MatchRoot("run_id")),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the task stage.",
+			},
+			"status_timestamps": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "A map of timestamps for key events during the task stage's lifecycle, such as `running_at`, `passed_at`, `failed_at`, and `errored_at`.",
+			},
+			"task_results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The results of each run task associated with this stage.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"task_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the run task that produced this result.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the task result.",
+						},
+						"message": schema.StringAttribute{
+							Computed:    true,
+							Description: "A human-readable message describing the task result.",
+						},
+						"url": schema.StringAttribute{
+							Computed:    true,
+							Description: "A URL where the full details of the task result can be viewed.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceTFETaskStage) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data modelTFETaskStage
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskStageID := data.TaskStageID.ValueString()
+	runID := data.RunID.ValueString()
+	stage := data.Stage.ValueString()
+
+	if taskStageID == "" && (runID == "" || stage == "") {
+		resp.Diagnostics.AddError(
+			"Invalid task stage lookup",
+			"Either `task_stage_id` or both `run_id` and `stage` must be set.",
+		)
+		return
+	}
+
+	var taskStage *tfe.TaskStage
+	if taskStageID != "" {
+		tflog.Debug(ctx, fmt.Sprintf("Reading task stage: %s", taskStageID))
+		ts, err := d.config.Client.TaskStages.Read(ctx, taskStageID, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading task stage", "Could not read task stage "+taskStageID+", unexpected error: "+err.Error())
+			return
+		}
+		taskStage = ts
+	} else {
+		tflog.Debug(ctx, fmt.Sprintf("Listing task stages for run: %s, stage: %s", runID, stage))
+		list, err := d.config.Client.TaskStages.List(ctx, runID, &tfe.TaskStageListOptions{})
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing task stages", "Could not list task stages for run "+runID+", unexpected error: "+err.Error())
+			return
+		}
+
+		for _, ts := range list.Items {
+			if string(ts.Stage) == stage {
+				taskStage = ts
+				break
+			}
+		}
+
+		if taskStage == nil {
+			resp.Diagnostics.AddError(
+				"Task stage not found",
+				fmt.Sprintf("No task stage for stage %q was found on run %s.", stage, runID),
+			)
+			return
+		}
+	}
+
+	result := modelFromTFETaskStage(taskStage)
+	// modelFromTFETaskStage only populates run_id from an expanded Run relationship; fall
+	// back to the configured run_id (if any) rather than leaving it blank.
+	if result.RunID.ValueString() == "" && runID != "" {
+		result.RunID = types.StringValue(runID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+const timeFormatRFC3339 = "2006-01-02T15:04:05Z07:00"
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestModelFromTFETaskStage(t *testing.T) {
+	runningAt := time.Date(2023, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	ts := &tfe.TaskStage{
+		ID:     "ts-1",
+		Stage:  tfe.PostPlan,
+		Status: "running",
+		StatusTimestamps: &tfe.TaskStageStatusTimestamps{
+			RunningAt: runningAt,
+		},
+		TaskResults: []*tfe.TaskResult{
+			{
+				RunTask: &tfe.RunTask{ID: "task-1"},
+				Status:  "running",
+				Message: "in progress",
+				URL:     "https://app.terraform.io/task-1",
+			},
+		},
+	}
+
+	got := modelFromTFETaskStage(ts)
+
+	if got.ID != types.StringValue("ts-1") {
+		t.Errorf("ID = %v, want ts-1", got.ID)
+	}
+	if got.Stage != types.StringValue(string(tfe.PostPlan)) {
+		t.Errorf("Stage = %v, want %v", got.Stage, tfe.PostPlan)
+	}
+	if got.Status != types.StringValue("running") {
+		t.Errorf("Status = %v, want running", got.Status)
+	}
+	if len(got.TaskResults) != 1 {
+		t.Fatalf("len(TaskResults) = %d, want 1", len(got.TaskResults))
+	}
+	if got.TaskResults[0].TaskID != types.StringValue("task-1") {
+		t.Errorf("TaskResults[0].TaskID = %v, want task-1", got.TaskResults[0].TaskID)
+	}
+
+	timestamps := make(map[string]string)
+	for k, v := range got.StatusTimestamps.Elements() {
+		s, ok := v.(types.String)
+		if !ok {
+			t.Fatalf("unexpected status_timestamps element type for %s", k)
+		}
+		timestamps[k] = s.ValueString()
+	}
+	if _, ok := timestamps["running_at"]; !ok {
+		t.Errorf("status_timestamps missing running_at, got %v", timestamps)
+	}
+	if _, ok := timestamps["passed_at"]; ok {
+		t.Errorf("status_timestamps should not include zero-value passed_at, got %v", timestamps)
+	}
+}
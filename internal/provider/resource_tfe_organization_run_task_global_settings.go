@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// resourceOrganizationRunTaskGlobalSettings manages the "global" flag on an organization
+// run task, which automatically associates the task with every workspace in the
+// organization. It is a sibling to resourceWorkspaceRunTask rather than a nested block on
+// the organization run task resource, so that enabling or disabling the global
+// association can be managed (and imported) independently of the task itself.
+type resourceOrganizationRunTaskGlobalSettings struct {
+	config ConfiguredClient
+}
+
+var _ resource.Resource = &resourceOrganizationRunTaskGlobalSettings{}
+var _ resource.ResourceWithConfigure = &resourceOrganizationRunTaskGlobalSettings{}
+var _ resource.ResourceWithImportState = &resourceOrganizationRunTaskGlobalSettings{}
+
+func NewOrganizationRunTaskGlobalSettingsResource() resource.Resource {
+	return &resourceOrganizationRunTaskGlobalSettings{}
+}
+
+type modelTFEOrganizationRunTaskGlobalSettings struct {
+	ID               types.String `tfsdk:"id"`
+	TaskID           types.String `tfsdk:"task_id"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	EnforcementLevel types.String `tfsdk:"enforcement_level"`
+	Stages           types.Set    `tfsdk:"stages"`
+}
+
+func modelFromTFERunTaskGlobalConfig(taskID string, v *tfe.RunTaskGlobalConfig) modelTFEOrganizationRunTaskGlobalSettings {
+	if v == nil {
+		v = &tfe.RunTaskGlobalConfig{}
+	}
+
+	stages := make([]attr.Value, 0, len(v.Stages))
+	for _, stage := range v.Stages {
+		stages = append(stages, types.StringValue(string(stage)))
+	}
+	stagesValue, _ := types.SetValue(types.StringType, stages)
+
+	return modelTFEOrganizationRunTaskGlobalSettings{
+		ID:               types.StringValue(taskID),
+		TaskID:           types.StringValue(taskID),
+		Enabled:          types.BoolValue(v.Enabled),
+		EnforcementLevel: types.StringValue(string(v.EnforcementLevel)),
+		Stages:           stagesValue,
+	}
+}
+
+func (r *resourceOrganizationRunTaskGlobalSettings) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_run_task_global_settings"
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *resourceOrganizationRunTaskGlobalSettings) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected resource Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+	}
+	r.config = client
+}
+
+func (r *resourceOrganizationRunTaskGlobalSettings) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     0,
+		Description: "Manages the global configuration of an organization run task, which automatically associates the task with every workspace in the organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Service-generated identifier for the global settings; matches `task_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"task_id": schema.StringAttribute{
+				Description: "The id of the organization Run task to configure globally.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the task should be automatically associated with every workspace in the organization.",
+				Required:    true,
+			},
+			"enforcement_level": schema.StringAttribute{
+				Description: fmt.Sprintf("The default enforcement level applied to the task's global associations. Valid values are %s.", sentenceList(
+					workspaceRunTaskEnforcementLevels(),
+					"`",
+					"`",
+					"and",
+				)),
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(workspaceRunTaskEnforcementLevels()...),
+				},
+			},
+			"stages": schema.SetAttribute{
+				Description: fmt.Sprintf("The stages to run the task in for every globally associated workspace. Valid values are %s.", sentenceList(
+					workspaceRunTaskStages(),
+					"`",
+					"`",
+					"and",
+				)),
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(workspaceRunTaskStages()...)),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceOrganizationRunTaskGlobalSettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state modelTFEOrganizationRunTaskGlobalSettings
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskID := state.TaskID.ValueString()
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading global settings for organization run task: %s", taskID))
+	task, err := r.config.Client.RunTasks.Read(ctx, taskID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Organization Run Task", "Could not read Organization Run Task "+taskID+", unexpected error: "+err.Error())
+		return
+	}
+
+	result := modelFromTFERunTaskGlobalConfig(taskID, task.Global)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+func (r *resourceOrganizationRunTaskGlobalSettings) applyGlobalConfig(ctx context.Context, plan modelTFEOrganizationRunTaskGlobalSettings) (*tfe.RunTask, error) {
+	taskID := plan.TaskID.ValueString()
+
+	var stages []tfe.Stage
+	for _, v := range plan.Stages.Elements() {
+		s, ok := v.(types.String)
+		if !ok {
+			continue
+		}
+		stages = append(stages, tfe.Stage(s.ValueString()))
+	}
+
+	level := tfe.TaskEnforcementLevel(plan.EnforcementLevel.ValueString())
+
+	options := tfe.RunTaskUpdateOptions{
+		Global: &tfe.RunTaskGlobalConfig{
+			Enabled:          plan.Enabled.ValueBool(),
+			EnforcementLevel: level,
+			Stages:           stages,
+		},
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update global settings for organization run task: %s", taskID))
+	return r.config.Client.RunTasks.Update(ctx, taskID, options)
+}
+
+func (r *resourceOrganizationRunTaskGlobalSettings) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan modelTFEOrganizationRunTaskGlobalSettings
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := r.applyGlobalConfig(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure global run task settings", err.Error())
+		return
+	}
+
+	result := modelFromTFERunTaskGlobalConfig(plan.TaskID.ValueString(), task.Global)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+func (r *resourceOrganizationRunTaskGlobalSettings) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan modelTFEOrganizationRunTaskGlobalSettings
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := r.applyGlobalConfig(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update global run task settings", err.Error())
+		return
+	}
+
+	result := modelFromTFERunTaskGlobalConfig(plan.TaskID.ValueString(), task.Global)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+func (r *resourceOrganizationRunTaskGlobalSettings) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state modelTFEOrganizationRunTaskGlobalSettings
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskID := state.TaskID.ValueString()
+
+	tflog.Debug(ctx, fmt.Sprintf("Disabling global settings for organization run task: %s", taskID))
+	_, err := r.config.Client.RunTasks.Update(ctx, taskID, tfe.RunTaskUpdateOptions{
+		Global: &tfe.RunTaskGlobalConfig{
+			Enabled: false,
+		},
+	})
+	if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
+		resp.Diagnostics.AddError(
+			"Error disabling global run task settings",
+			fmt.Sprintf("Couldn't disable global settings for task %s: %s", taskID, err.Error()),
+		)
+	}
+	// Resource is implicitly deleted from resp.State if diagnostics have no errors.
+}
+
+func (r *resourceOrganizationRunTaskGlobalSettings) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("task_id"), req, resp)
+}
@@ -67,21 +67,36 @@ func NewWorkspaceRunTaskResource() resource.Resource {
 	return &resourceWorkspaceRunTask{}
 }
 
+// workspaceRunTaskSourceGlobal and workspaceRunTaskSourceExplicit identify whether a
+// workspace's association with a run task was created automatically because the task
+// is "global" to the organization, or explicitly via this resource.
+const (
+	workspaceRunTaskSourceGlobal   = "global"
+	workspaceRunTaskSourceExplicit = "explicit"
+)
+
 type modelTFEWorkspaceRunTaskV0 struct {
 	ID               types.String `tfsdk:"id"`
 	WorkspaceID      types.String `tfsdk:"workspace_id"`
 	TaskID           types.String `tfsdk:"task_id"`
 	EnforcementLevel types.String `tfsdk:"enforcement_level"`
 	Stage            types.String `tfsdk:"stage"`
+	Source           types.String `tfsdk:"source"`
 }
 
 func modelFromTFEWorkspaceRunTask(v *tfe.WorkspaceRunTask) modelTFEWorkspaceRunTaskV0 {
+	source := workspaceRunTaskSourceExplicit
+	if v.RunTask != nil && v.RunTask.Global != nil && v.RunTask.Global.Enabled {
+		source = workspaceRunTaskSourceGlobal
+	}
+
 	return modelTFEWorkspaceRunTaskV0{
 		ID:               types.StringValue(v.ID),
 		WorkspaceID:      types.StringValue(v.Workspace.ID),
 		TaskID:           types.StringValue(v.RunTask.ID),
 		EnforcementLevel: types.StringValue(string(v.EnforcementLevel)),
 		Stage:            types.StringValue(string(v.Stage)),
+		Source:           types.StringValue(source),
 	}
 }
 
@@ -157,6 +172,13 @@ func (r *resourceWorkspaceRunTask) Schema(ctx context.Context, req resource.Sche
 					stringvalidator.OneOf(workspaceRunTaskStages()...),
 				},
 			},
+			"source": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the association was created explicitly by this resource or automatically because the task is `%s`. Valid values are `%s` and `%s`.", workspaceRunTaskSourceGlobal, workspaceRunTaskSourceExplicit, workspaceRunTaskSourceGlobal),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -208,6 +230,18 @@ func (r *resourceWorkspaceRunTask) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	// A "global" run task is automatically associated with every workspace in the
+	// organization server-side, so Terraform must adopt that association rather than
+	// try to create a duplicate one.
+	if existing, err := fetchGlobalWorkspaceRunTask(ctx, r.config.Client, workspaceID, taskID); err != nil {
+		resp.Diagnostics.AddError("Error retrieving workspace run task", "Could not list Workspace Run Tasks for "+workspaceID+", unexpected error: "+err.Error())
+		return
+	} else if existing != nil {
+		result := modelFromTFEWorkspaceRunTask(existing)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+		return
+	}
+
 	stage := tfe.Stage(plan.Stage.ValueString())
 	level := tfe.TaskEnforcementLevel(plan.EnforcementLevel.ValueString())
 
@@ -282,6 +316,13 @@ func (r *resourceWorkspaceRunTask) Delete(ctx context.Context, req resource.Dele
 	wstaskID := state.ID.ValueString()
 	workspaceID := state.WorkspaceID.ValueString()
 
+	if state.Source.ValueString() == workspaceRunTaskSourceGlobal {
+		// This association is managed server-side by the task's global configuration,
+		// so there is nothing for Terraform to delete; just drop it from state.
+		tflog.Debug(ctx, fmt.Sprintf("Skipping delete of globally managed task %s in workspace %s", wstaskID, workspaceID))
+		return
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Delete task %s in workspace %s", wstaskID, workspaceID))
 	err := r.config.Client.WorkspaceRunTasks.Delete(ctx, workspaceID, wstaskID)
 	// Ignore 404s for delete
@@ -323,3 +364,33 @@ func (r *resourceWorkspaceRunTask) ImportState(ctx context.Context, req resource
 		resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
 	}
 }
+
+// fetchGlobalWorkspaceRunTask looks for an existing association between workspaceID and
+// taskID that was created automatically because the task is "global", so that Create can
+// adopt it into state instead of fighting with the server-side auto-attachment. It returns
+// nil, nil if no such association exists.
+func fetchGlobalWorkspaceRunTask(ctx context.Context, client *tfe.Client, workspaceID string, taskID string) (*tfe.WorkspaceRunTask, error) {
+	options := &tfe.WorkspaceRunTaskListOptions{}
+	for {
+		list, err := client.WorkspaceRunTasks.List(ctx, workspaceID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, wstask := range list.Items {
+			if wstask.RunTask == nil || wstask.RunTask.ID != taskID {
+				continue
+			}
+			if wstask.RunTask.Global != nil && wstask.RunTask.Global.Enabled {
+				return wstask, nil
+			}
+		}
+
+		if list.CurrentPage >= list.TotalPages {
+			break
+		}
+		options.PageNumber = list.NextPage
+	}
+
+	return nil, nil
+}
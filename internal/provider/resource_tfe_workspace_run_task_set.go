@@ -0,0 +1,418 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// resourceWorkspaceRunTaskSet fans a single task/enforcement_level/stage configuration
+// out across many workspaces, so that organizations rolling out a task org-wide don't
+// need one resourceWorkspaceRunTask per workspace.
+type resourceWorkspaceRunTaskSet struct {
+	config ConfiguredClient
+}
+
+var _ resource.Resource = &resourceWorkspaceRunTaskSet{}
+var _ resource.ResourceWithConfigure = &resourceWorkspaceRunTaskSet{}
+
+func NewWorkspaceRunTaskSetResource() resource.Resource {
+	return &resourceWorkspaceRunTaskSet{}
+}
+
+type modelWorkspaceRunTaskSetResult struct {
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+	ID          types.String `tfsdk:"id"`
+	Error       types.String `tfsdk:"error"`
+}
+
+type modelTFEWorkspaceRunTaskSet struct {
+	ID                 types.String                     `tfsdk:"id"`
+	Organization       types.String                     `tfsdk:"organization"`
+	TaskID             types.String                     `tfsdk:"task_id"`
+	EnforcementLevel   types.String                     `tfsdk:"enforcement_level"`
+	Stage              types.String                     `tfsdk:"stage"`
+	WorkspaceIDs       types.Set                        `tfsdk:"workspace_ids"`
+	WorkspaceTagFilter types.Set                        `tfsdk:"workspace_tag_filter"`
+	Results            []modelWorkspaceRunTaskSetResult `tfsdk:"results"`
+}
+
+func (r *resourceWorkspaceRunTaskSet) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_run_task_set"
+}
+
+// Configure implements resource.ResourceWithConfigure
+func (r *resourceWorkspaceRunTaskSet) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected resource Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+	}
+	r.config = client
+}
+
+func (r *resourceWorkspaceRunTaskSet) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     0,
+		Description: "Associates a Run task with many workspaces at once, either by an explicit set of workspace IDs or by a set of workspace tags. This is a companion to `tfe_workspace_run_task` for rolling a task out across many workspaces without declaring one resource per workspace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Service-generated identifier for the workspace task set; matches `task_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				Description: "Name of the organization that the task and target workspaces belong to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"task_id": schema.StringAttribute{
+				Description: "The id of the Run task to associate with the target workspaces.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enforcement_level": schema.StringAttribute{
+				Description: fmt.Sprintf("The enforcement level of the task. Valid values are %s.", sentenceList(
+					workspaceRunTaskEnforcementLevels(),
+					"`",
+					"`",
+					"and",
+				)),
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(workspaceRunTaskEnforcementLevels()...),
+				},
+			},
+			"stage": schema.StringAttribute{
+				Description: fmt.Sprintf("The stage to run the task in. Valid values are %s.", sentenceList(
+					workspaceRunTaskStages(),
+					"`",
+					"`",
+					"and",
+				)),
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(string(tfe.PostPlan)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(workspaceRunTaskStages()...),
+				},
+			},
+			"workspace_ids": schema.SetAttribute{
+				Description: "An explicit set of workspace IDs to associate the task with. Conflicts with `workspace_tag_filter`; one of the two is required.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("workspace_tag_filter")),
+					setvalidator.AtLeastOneOf(path.MatchRoot("workspace_ids"), path.MatchRoot("workspace_tag_filter")),
+				},
+			},
+			"workspace_tag_filter": schema.SetAttribute{
+				Description: "A set of workspace tag names; the task is associated with every workspace in `organization` carrying at least one of these tags. Conflicts with `workspace_ids`; one of the two is required.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("workspace_ids")),
+					setvalidator.AtLeastOneOf(path.MatchRoot("workspace_ids"), path.MatchRoot("workspace_tag_filter")),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The per-workspace outcome of associating the task. Workspaces that failed to associate are reported here with a non-empty `error` instead of failing the entire apply.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"workspace_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The id of the target workspace.",
+						},
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Service-generated identifier for the workspace task, or empty if the task could not be associated. An existing association whose update failed keeps its identifier.",
+						},
+						"error": schema.StringAttribute{
+							Computed:    true,
+							Description: "The error encountered associating the task with this workspace, or empty on success.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resolveTargetWorkspaceIDs returns the set of workspace IDs that should have the task
+// associated with them, either from the explicit workspace_ids set or by listing
+// workspaces in the organization that carry one of workspace_tag_filter's tags.
+func (r *resourceWorkspaceRunTaskSet) resolveTargetWorkspaceIDs(ctx context.Context, plan modelTFEWorkspaceRunTaskSet) ([]string, error) {
+	if !plan.WorkspaceIDs.IsNull() {
+		var ids []string
+		for _, v := range plan.WorkspaceIDs.Elements() {
+			if s, ok := v.(types.String); ok {
+				ids = append(ids, s.ValueString())
+			}
+		}
+		return ids, nil
+	}
+
+	var tagNames []string
+	for _, v := range plan.WorkspaceTagFilter.Elements() {
+		if s, ok := v.(types.String); ok {
+			tagNames = append(tagNames, s.ValueString())
+		}
+	}
+
+	var ids []string
+	opts := &tfe.WorkspaceListOptions{
+		Tags: strings.Join(tagNames, ","),
+	}
+	for {
+		wl, err := r.config.Client.Workspaces.List(ctx, plan.Organization.ValueString(), opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, ws := range wl.Items {
+			ids = append(ids, ws.ID)
+		}
+		if wl.CurrentPage >= wl.TotalPages {
+			break
+		}
+		opts.PageNumber = wl.NextPage
+	}
+
+	return ids, nil
+}
+
+// applyToWorkspaces creates or updates the workspace run task association for every
+// workspace ID in targetIDs, reusing any matching result already present in
+// existingResults so successfully-associated workspaces aren't recreated on every apply.
+func (r *resourceWorkspaceRunTaskSet) applyToWorkspaces(ctx context.Context, plan modelTFEWorkspaceRunTaskSet, task *tfe.RunTask, targetIDs []string, existingResults []modelWorkspaceRunTaskSetResult) []modelWorkspaceRunTaskSetResult {
+	existingByWorkspace := make(map[string]modelWorkspaceRunTaskSetResult, len(existingResults))
+	for _, res := range existingResults {
+		existingByWorkspace[res.WorkspaceID.ValueString()] = res
+	}
+
+	stage := tfe.Stage(plan.Stage.ValueString())
+	level := tfe.TaskEnforcementLevel(plan.EnforcementLevel.ValueString())
+
+	results := make([]modelWorkspaceRunTaskSetResult, 0, len(targetIDs))
+	for _, workspaceID := range targetIDs {
+		// Route to Update whenever a real association already exists (ID is non-empty),
+		// even if a previous apply's Update failed, so retries target the existing
+		// resource instead of attempting to Create a duplicate.
+		if existing, ok := existingByWorkspace[workspaceID]; ok && existing.ID.ValueString() != "" {
+			wstask, err := r.config.Client.WorkspaceRunTasks.Update(ctx, workspaceID, existing.ID.ValueString(), tfe.WorkspaceRunTaskUpdateOptions{
+				EnforcementLevel: level,
+				Stage:            &stage,
+			})
+			if err != nil {
+				// The association still exists server-side even though this update
+				// failed, so keep existing.ID around rather than losing track of it:
+				// a future apply must retry Update/Delete against the real resource,
+				// not Create a duplicate.
+				results = append(results, modelWorkspaceRunTaskSetResult{
+					WorkspaceID: types.StringValue(workspaceID),
+					ID:          existing.ID,
+					Error:       types.StringValue(err.Error()),
+				})
+				continue
+			}
+			results = append(results, modelWorkspaceRunTaskSetResult{
+				WorkspaceID: types.StringValue(workspaceID),
+				ID:          types.StringValue(wstask.ID),
+				Error:       types.StringValue(""),
+			})
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Create task %s in workspace: %s", task.ID, workspaceID))
+		wstask, err := r.config.Client.WorkspaceRunTasks.Create(ctx, workspaceID, tfe.WorkspaceRunTaskCreateOptions{
+			RunTask:          task,
+			EnforcementLevel: level,
+			Stage:            &stage,
+		})
+		if err != nil {
+			results = append(results, modelWorkspaceRunTaskSetResult{
+				WorkspaceID: types.StringValue(workspaceID),
+				ID:          types.StringValue(""),
+				Error:       types.StringValue(err.Error()),
+			})
+			continue
+		}
+
+		results = append(results, modelWorkspaceRunTaskSetResult{
+			WorkspaceID: types.StringValue(workspaceID),
+			ID:          types.StringValue(wstask.ID),
+			Error:       types.StringValue(""),
+		})
+	}
+
+	return results
+}
+
+func (r *resourceWorkspaceRunTaskSet) createOrUpdate(ctx context.Context, plan modelTFEWorkspaceRunTaskSet, existingResults []modelWorkspaceRunTaskSetResult, diags *diag.Diagnostics) modelTFEWorkspaceRunTaskSet {
+	taskID := plan.TaskID.ValueString()
+	task, err := r.config.Client.RunTasks.Read(ctx, taskID)
+	if err != nil {
+		diags.AddError("Error retrieving task", "Could not read Organization Run Task "+taskID+", unexpected error: "+err.Error())
+		return plan
+	}
+
+	targetIDs, err := r.resolveTargetWorkspaceIDs(ctx, plan)
+	if err != nil {
+		diags.AddError("Error resolving target workspaces", "Could not resolve target workspaces for task "+taskID+", unexpected error: "+err.Error())
+		return plan
+	}
+
+	// Workspaces present in state but no longer targeted need their association removed.
+	targeted := make(map[string]bool, len(targetIDs))
+	for _, id := range targetIDs {
+		targeted[id] = true
+	}
+	for _, res := range existingResults {
+		workspaceID := res.WorkspaceID.ValueString()
+		// res.ID is the source of truth for whether a real association exists,
+		// regardless of whether its last Update happened to fail.
+		if targeted[workspaceID] || res.ID.ValueString() == "" {
+			continue
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Delete task %s in workspace %s (no longer targeted)", res.ID.ValueString(), workspaceID))
+		if err := r.config.Client.WorkspaceRunTasks.Delete(ctx, workspaceID, res.ID.ValueString()); err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
+			diags.AddWarning(
+				"Error removing workspace run task",
+				fmt.Sprintf("Couldn't remove task %s from workspace %s that is no longer targeted: %s", res.ID.ValueString(), workspaceID, err.Error()),
+			)
+		}
+	}
+
+	results := r.applyToWorkspaces(ctx, plan, task, targetIDs, existingResults)
+	for _, res := range results {
+		if res.Error.ValueString() != "" {
+			diags.AddWarning(
+				"Error associating workspace run task",
+				fmt.Sprintf("Couldn't associate task %s with workspace %s: %s", taskID, res.WorkspaceID.ValueString(), res.Error.ValueString()),
+			)
+		}
+	}
+
+	plan.ID = types.StringValue(taskID)
+	plan.Results = results
+	return plan
+}
+
+func (r *resourceWorkspaceRunTaskSet) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan modelTFEWorkspaceRunTaskSet
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := r.createOrUpdate(ctx, plan, nil, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+func (r *resourceWorkspaceRunTaskSet) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan modelTFEWorkspaceRunTaskSet
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state modelTFEWorkspaceRunTaskSet
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := r.createOrUpdate(ctx, plan, state.Results, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+func (r *resourceWorkspaceRunTaskSet) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state modelTFEWorkspaceRunTaskSet
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results := make([]modelWorkspaceRunTaskSetResult, 0, len(state.Results))
+	for _, res := range state.Results {
+		workspaceID := res.WorkspaceID.ValueString()
+		if res.ID.ValueString() == "" {
+			results = append(results, res)
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Reading workspace run task %s in workspace %s", res.ID.ValueString(), workspaceID))
+		wstask, err := r.config.Client.WorkspaceRunTasks.Read(ctx, workspaceID, res.ID.ValueString())
+		if err != nil {
+			if errors.Is(err, tfe.ErrResourceNotFound) {
+				continue
+			}
+			resp.Diagnostics.AddError("Error reading Workspace Run Task", "Could not read Workspace Run Task, unexpected error: "+err.Error())
+			return
+		}
+		results = append(results, modelWorkspaceRunTaskSetResult{
+			WorkspaceID: types.StringValue(workspaceID),
+			ID:          types.StringValue(wstask.ID),
+			Error:       types.StringValue(""),
+		})
+	}
+
+	state.Results = results
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceWorkspaceRunTaskSet) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state modelTFEWorkspaceRunTaskSet
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, res := range state.Results {
+		if res.ID.ValueString() == "" {
+			continue
+		}
+		workspaceID := res.WorkspaceID.ValueString()
+		tflog.Debug(ctx, fmt.Sprintf("Delete task %s in workspace %s", res.ID.ValueString(), workspaceID))
+		if err := r.config.Client.WorkspaceRunTasks.Delete(ctx, workspaceID, res.ID.ValueString()); err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
+			resp.Diagnostics.AddError(
+				"Error deleting workspace run task",
+				fmt.Sprintf("Couldn't delete task %s in workspace %s: %s", res.ID.ValueString(), workspaceID, err.Error()),
+			)
+		}
+	}
+	// Resource is implicitly deleted from resp.State if diagnostics have no errors.
+}
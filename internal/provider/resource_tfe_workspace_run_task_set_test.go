@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResolveTargetWorkspaceIDs_explicit(t *testing.T) {
+	workspaceIDs, diags := types.SetValue(types.StringType, []attr.Value{
+		types.StringValue("ws-1"),
+		types.StringValue("ws-2"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics building workspace_ids: %v", diags)
+	}
+
+	plan := modelTFEWorkspaceRunTaskSet{
+		Organization: types.StringValue("my-org"),
+		WorkspaceIDs: workspaceIDs,
+	}
+
+	r := &resourceWorkspaceRunTaskSet{}
+
+	got, err := r.resolveTargetWorkspaceIDs(context.Background(), plan)
+	if err != nil {
+		t.Fatalf("resolveTargetWorkspaceIDs() error = %v", err)
+	}
+
+	want := map[string]bool{"ws-1": true, "ws-2": true}
+	if len(got) != len(want) {
+		t.Fatalf("resolveTargetWorkspaceIDs() = %v, want ids %v", got, want)
+	}
+	for _, id := range got {
+		if !want[id] {
+			t.Errorf("resolveTargetWorkspaceIDs() returned unexpected id %q", id)
+		}
+	}
+}
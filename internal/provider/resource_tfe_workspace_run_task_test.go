@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestModelFromTFEWorkspaceRunTask_source(t *testing.T) {
+	cases := map[string]struct {
+		runTask *tfe.RunTask
+		want    string
+	}{
+		"explicit when run task has no global config": {
+			runTask: &tfe.RunTask{ID: "task-1"},
+			want:    workspaceRunTaskSourceExplicit,
+		},
+		"explicit when global config is present but disabled": {
+			runTask: &tfe.RunTask{ID: "task-1", Global: &tfe.RunTaskGlobalConfig{Enabled: false}},
+			want:    workspaceRunTaskSourceExplicit,
+		},
+		"global when global config is enabled": {
+			runTask: &tfe.RunTask{ID: "task-1", Global: &tfe.RunTaskGlobalConfig{Enabled: true}},
+			want:    workspaceRunTaskSourceGlobal,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			wstask := &tfe.WorkspaceRunTask{
+				ID:        "wstask-1",
+				Workspace: &tfe.Workspace{ID: "ws-1"},
+				RunTask:   tc.runTask,
+			}
+
+			got := modelFromTFEWorkspaceRunTask(wstask)
+			if got.Source != types.StringValue(tc.want) {
+				t.Errorf("Source = %v, want %v", got.Source, tc.want)
+			}
+		})
+	}
+}